@@ -1,6 +1,10 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+)
 
 // Add takes two integer parameters and performs addition
 // It calculates the sum of the two numbers and prints the result
@@ -13,9 +17,15 @@ import "fmt"
 //   - Add(5, 6) outputs "the addition is 11"
 //   - Add(-5, 6) outputs "the addition is 1"
 func Add(a, b int) {
+	AddTo(os.Stdout, a, b)
+}
+
+// AddTo works like Add but writes the result to w instead of the console
+// This lets callers such as the interactive prompt choose where output goes
+func AddTo(w io.Writer, a, b int) {
 	// Calculate the sum of the two input numbers
 	sum := a + b
 
 	// Print the result in the specified format
-	fmt.Printf("the addition is %d\n", sum)
+	fmt.Fprintf(w, "the addition is %d\n", sum)
 }
@@ -1,8 +1,17 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+)
 
 // SayHello prints "HELLO WORLD" to the console
 func SayHello() {
-	fmt.Println("HELLO WORLD")
+	SayHelloTo(os.Stdout)
+}
+
+// SayHelloTo writes "HELLO WORLD" to w
+func SayHelloTo(w io.Writer) {
+	fmt.Fprintln(w, "HELLO WORLD")
 }
@@ -1,10 +1,27 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 )
 
 func main() {
+	repl := flag.Bool("repl", false, "start an interactive prompt")
+	showVersion := flag.Bool("version", false, "print version information and exit")
+	flag.Parse()
+
+	if *showVersion {
+		PrintVersion(os.Stdout)
+		return
+	}
+
+	// In REPL mode the commands are typed by the user instead of run once
+	if *repl {
+		RunREPL(os.Stdin, os.Stdout)
+		return
+	}
+
 	fmt.Println("Starting the demo application...")
 	SayHello()
 
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// replUsage holds one usage line per command understood by the
+// interactive prompt; it is printed by the "help" command
+var replUsage = []string{
+	"hello             print the greeting",
+	"calc <a> <b>      add two integers",
+	"history           list previously entered commands",
+	"help              show this message",
+	"exit              leave the prompt",
+}
+
+// RunREPL starts an interactive prompt reading commands from in and
+// writing all prompts and results to out
+// Each command is backed by the same functions main uses (SayHello, Add)
+// Every non-empty line is recorded so "history" can print it back
+// The prompt ends on "exit", when the input is closed, or on a read error
+func RunREPL(in io.Reader, out io.Writer) {
+	scanner := bufio.NewScanner(in)
+	var history []string
+
+	fmt.Fprintln(out, "Type \"help\" for a list of commands.")
+	for {
+		fmt.Fprint(out, "demo> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			// Scan also stops on read errors and over-long lines, not just EOF
+			if err := scanner.Err(); err != nil {
+				fmt.Fprintf(out, "error reading input: %v\n", err)
+			}
+			return
+		}
+
+		// Split the line into the command name and its arguments
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		history = append(history, strings.Join(fields, " "))
+
+		switch cmd, args := fields[0], fields[1:]; cmd {
+		case "hello":
+			SayHelloTo(out)
+		case "calc":
+			runCalc(out, args)
+		case "history":
+			for i, line := range history {
+				fmt.Fprintf(out, "%4d  %s\n", i+1, line)
+			}
+		case "help":
+			for _, line := range replUsage {
+				fmt.Fprintln(out, "  "+line)
+			}
+		case "exit":
+			return
+		default:
+			fmt.Fprintf(out, "unknown command %q, type \"help\" for a list of commands\n", cmd)
+		}
+	}
+}
+
+// runCalc parses the two operands of the calc command and hands them to AddTo
+// Operands and the sum must fit in an int; a sum that would wrap around
+// is reported instead of printed
+func runCalc(out io.Writer, args []string) {
+	if len(args) != 2 {
+		fmt.Fprintln(out, "usage: calc <a> <b>")
+		return
+	}
+
+	a, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Fprintf(out, "invalid number %q\n", args[0])
+		return
+	}
+	b, err := strconv.Atoi(args[1])
+	if err != nil {
+		fmt.Fprintf(out, "invalid number %q\n", args[1])
+		return
+	}
+
+	if (b > 0 && a > math.MaxInt-b) || (b < 0 && a < math.MinInt-b) {
+		fmt.Fprintf(out, "sum of %d and %d is out of range\n", a, b)
+		return
+	}
+
+	AddTo(out, a, b)
+}
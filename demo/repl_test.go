@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRunREPL(t *testing.T) {
+	input := strings.Join([]string{
+		"calc 5 6",
+		"calc x 1",
+		"calc 1",
+		"calc 9223372036854775807 1",
+		"calc -9223372036854775808 -1",
+		"calc 99999999999999999999 1",
+		"frobnicate",
+		"",
+		"history",
+	}, "\n")
+
+	var out strings.Builder
+	RunREPL(strings.NewReader(input), &out)
+	got := out.String()
+
+	want := []string{
+		"the addition is 11\n",
+		"invalid number \"x\"\n",
+		"usage: calc <a> <b>\n",
+		"sum of 9223372036854775807 and 1 is out of range\n",
+		"sum of -9223372036854775808 and -1 is out of range\n",
+		"invalid number \"99999999999999999999\"\n",
+		"unknown command \"frobnicate\"",
+		"   1  calc 5 6\n   2  calc x 1\n   3  calc 1\n",
+		"   7  frobnicate\n   8  history\n",
+	}
+	for _, w := range want {
+		if !strings.Contains(got, w) {
+			t.Errorf("output missing %q\nfull output:\n%s", w, got)
+		}
+	}
+
+	// EOF ends the prompt after the final "demo> " without an error
+	if !strings.HasSuffix(got, "demo> \n") {
+		t.Errorf("output should end with an empty prompt on EOF, got:\n%s", got)
+	}
+	if strings.Contains(got, "error reading input") {
+		t.Errorf("EOF should not be reported as an error, got:\n%s", got)
+	}
+}
+
+func TestRunREPLExit(t *testing.T) {
+	var out strings.Builder
+	RunREPL(strings.NewReader("exit\nhello\n"), &out)
+
+	if strings.Contains(out.String(), "HELLO WORLD") {
+		t.Errorf("commands after exit should not run, got:\n%s", out.String())
+	}
+}
+
+// errReader fails every read with err
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestRunREPLReadError(t *testing.T) {
+	tests := []struct {
+		name string
+		in   io.Reader
+		want string
+	}{
+		{"read error", errReader{errors.New("boom")}, "error reading input: boom"},
+		{"line too long", strings.NewReader(strings.Repeat("a", 70*1024)), "error reading input: bufio.Scanner: token too long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			RunREPL(tt.in, &out)
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("output missing %q, got:\n%s", tt.want, out.String())
+			}
+		})
+	}
+}
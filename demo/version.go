@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime/debug"
+)
+
+// Build information, normally set at build time with -ldflags, e.g.
+//
+//	go build -ldflags "-X main.version=v1.0.0 -X main.commit=abc123 -X main.buildTime=2024-01-01T00:00:00Z"
+//
+// When version or commit is left empty it is filled from the module's
+// build info; buildTime is only known when set here
+var (
+	version   = ""
+	commit    = ""
+	buildTime = ""
+)
+
+// PrintVersion writes the version, commit and build time of the binary to w
+// Values not provided via -ldflags are read from debug.ReadBuildInfo,
+// which Go fills in from the module version and VCS settings
+func PrintVersion(w io.Writer) {
+	info, _ := debug.ReadBuildInfo()
+	writeVersion(w, info)
+}
+
+// writeVersion does the work of PrintVersion using the given build info,
+// which may be nil when the binary carries none
+// A commit built from a tree with uncommitted changes is marked "-dirty",
+// and the VCS commit time is shown separately from the build time
+func writeVersion(w io.Writer, info *debug.BuildInfo) {
+	v, c := version, commit
+	var commitTime string
+
+	if info != nil {
+		if v == "" {
+			v = info.Main.Version
+		}
+
+		var revision string
+		var modified bool
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				revision = s.Value
+			case "vcs.time":
+				commitTime = s.Value
+			case "vcs.modified":
+				modified = s.Value == "true"
+			}
+		}
+
+		if c == "" && revision != "" {
+			c = revision
+			if modified {
+				c += "-dirty"
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "demo %s\n", orUnknown(v))
+	fmt.Fprintf(w, "commit:      %s\n", orUnknown(c))
+	fmt.Fprintf(w, "commit time: %s\n", orUnknown(commitTime))
+	fmt.Fprintf(w, "build time:  %s\n", orUnknown(buildTime))
+}
+
+// orUnknown returns s, or "unknown" when s is empty
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
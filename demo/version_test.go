@@ -0,0 +1,72 @@
+package main
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestWriteVersion(t *testing.T) {
+	info := &debug.BuildInfo{
+		Main: debug.Module{Version: "v0.1.0"},
+		Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "abc123"},
+			{Key: "vcs.time", Value: "2024-01-01T00:00:00Z"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		version, commit, built string
+		info                   *debug.BuildInfo
+		want                   string
+	}{
+		{
+			name: "no build info",
+			want: "demo unknown\ncommit:      unknown\ncommit time: unknown\nbuild time:  unknown\n",
+		},
+		{
+			name: "from build info",
+			info: info,
+			want: "demo v0.1.0\ncommit:      abc123-dirty\ncommit time: 2024-01-01T00:00:00Z\nbuild time:  unknown\n",
+		},
+		{
+			name:    "ldflags override build info",
+			version: "v1.2.3",
+			commit:  "def456",
+			built:   "2024-02-02T00:00:00Z",
+			info:    info,
+			want:    "demo v1.2.3\ncommit:      def456\ncommit time: 2024-01-01T00:00:00Z\nbuild time:  2024-02-02T00:00:00Z\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setBuildVars(t, tt.version, tt.commit, tt.built)
+
+			var out strings.Builder
+			writeVersion(&out, tt.info)
+			if got := out.String(); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrUnknown(t *testing.T) {
+	if got := orUnknown(""); got != "unknown" {
+		t.Errorf("orUnknown(\"\") = %q, want \"unknown\"", got)
+	}
+	if got := orUnknown("v1"); got != "v1" {
+		t.Errorf("orUnknown(\"v1\") = %q, want \"v1\"", got)
+	}
+}
+
+// setBuildVars sets the -ldflags variables for one test and restores them after
+func setBuildVars(t *testing.T, v, c, b string) {
+	t.Helper()
+	oldV, oldC, oldB := version, commit, buildTime
+	version, commit, buildTime = v, c, b
+	t.Cleanup(func() { version, commit, buildTime = oldV, oldC, oldB })
+}